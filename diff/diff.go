@@ -0,0 +1,239 @@
+// Package diff renders a unified diff between a deep, stable pretty-print of
+// two arbitrary values. It exists so expect can show useful failure output
+// for large structs, maps, and multi-line strings without taking on an
+// external pretty-printing or diffing dependency.
+package diff
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Context is the number of unchanged lines kept around each changed region
+// in the rendered unified diff.
+const Context = 3
+
+// Render returns a unified diff ("--- expected\n+++ actual\n...") comparing
+// the pretty-printed form of expected and actual, along with whether the two
+// values are composite enough (struct, map, slice, array, a pointer to one of
+// these, or multi-line string) for a diff to be worth showing at all.
+func Render(expected, actual interface{}) (string, bool) {
+	if !isComposite(expected) && !isComposite(actual) {
+		return "", false
+	}
+	expectedLines := strings.Split(Sprint(expected), "\n")
+	actualLines := strings.Split(Sprint(actual), "\n")
+	if len(expectedLines) <= 1 && len(actualLines) <= 1 {
+		return "", false
+	}
+	return unified(expectedLines, actualLines), true
+}
+
+func isComposite(v interface{}) bool {
+	if v == nil {
+		return false
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array, reflect.Ptr:
+		return true
+	case reflect.String:
+		return strings.Contains(rv.String(), "\n")
+	}
+	return false
+}
+
+// Sprint deterministically pretty-prints v: map keys are sorted, cycles that
+// pass through a pointer are detected rather than recursed into, and typed
+// nils are labelled with their type instead of printing as a bare "<nil>".
+// A cycle made only of maps, slices and interfaces is not detected.
+func Sprint(v interface{}) string {
+	buf := &bytes.Buffer{}
+	print(buf, reflect.ValueOf(v), make(map[uintptr]bool), 0)
+	return buf.String()
+}
+
+func print(buf *bytes.Buffer, v reflect.Value, seen map[uintptr]bool, depth int) {
+	if !v.IsValid() {
+		buf.WriteString("<nil>")
+		return
+	}
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			fmt.Fprintf(buf, "(%s) <nil>", v.Type())
+			return
+		}
+		if v.Kind() == reflect.Ptr {
+			addr := v.Pointer()
+			if seen[addr] {
+				buf.WriteString("<cycle>")
+				return
+			}
+			seen[addr] = true
+			defer delete(seen, addr)
+			buf.WriteByte('&')
+		}
+		print(buf, v.Elem(), seen, depth)
+	case reflect.Struct:
+		fmt.Fprintf(buf, "%s{\n", v.Type())
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			indent(buf, depth+1)
+			fmt.Fprintf(buf, "%s: ", field.Name)
+			print(buf, v.Field(i), seen, depth+1)
+			buf.WriteString(",\n")
+		}
+		indent(buf, depth)
+		buf.WriteByte('}')
+	case reflect.Map:
+		fmt.Fprintf(buf, "%s{\n", v.Type())
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+		})
+		for _, k := range keys {
+			indent(buf, depth+1)
+			print(buf, k, seen, depth+1)
+			buf.WriteString(": ")
+			print(buf, v.MapIndex(k), seen, depth+1)
+			buf.WriteString(",\n")
+		}
+		indent(buf, depth)
+		buf.WriteByte('}')
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			fmt.Fprintf(buf, "(%s) <nil>", v.Type())
+			return
+		}
+		fmt.Fprintf(buf, "%s{\n", v.Type())
+		for i := 0; i < v.Len(); i++ {
+			indent(buf, depth+1)
+			print(buf, v.Index(i), seen, depth+1)
+			buf.WriteString(",\n")
+		}
+		indent(buf, depth)
+		buf.WriteByte('}')
+	case reflect.String:
+		s := v.String()
+		if strings.Contains(s, "\n") {
+			// Keep real line breaks so a multi-line string diffs
+			// line-by-line instead of as one long escaped line.
+			buf.WriteString(s)
+		} else {
+			fmt.Fprintf(buf, "%q", s)
+		}
+	default:
+		fmt.Fprintf(buf, "%v", v.Interface())
+	}
+}
+
+func indent(buf *bytes.Buffer, depth int) {
+	for i := 0; i < depth; i++ {
+		buf.WriteString("  ")
+	}
+}
+
+// unified builds a `--- expected / +++ actual` unified diff from the longest
+// common subsequence of the two line slices, using a Myers-style LCS table.
+func unified(expected, actual []string) string {
+	ops := lcsOps(expected, actual)
+
+	out := &bytes.Buffer{}
+	out.WriteString("--- expected\n")
+	out.WriteString("+++ actual\n")
+
+	printed := 0
+	for i := 0; i < len(ops); {
+		if ops[i].kind == opEqual {
+			i++
+			continue
+		}
+		start := i
+		for i < len(ops) && ops[i].kind != opEqual {
+			i++
+		}
+		contextStart := start - Context
+		if contextStart < printed {
+			contextStart = printed
+		}
+		contextEnd := i + Context
+		if contextEnd > len(ops) {
+			contextEnd = len(ops)
+		}
+		for j := contextStart; j < contextEnd; j++ {
+			switch ops[j].kind {
+			case opEqual:
+				fmt.Fprintf(out, "  %s\n", ops[j].line)
+			case opDelete:
+				fmt.Fprintf(out, "- %s\n", ops[j].line)
+			case opInsert:
+				fmt.Fprintf(out, "+ %s\n", ops[j].line)
+			}
+		}
+		i = contextEnd
+		printed = contextEnd
+	}
+	return out.String()
+}
+
+type opKind int
+
+const (
+	opEqual opKind = iota
+	opDelete
+	opInsert
+)
+
+type op struct {
+	kind opKind
+	line string
+}
+
+// lcsOps computes the longest common subsequence of a and b and returns the
+// edit script (equal/delete/insert) that turns a into b.
+func lcsOps(a, b []string) []op {
+	n, m := len(a), len(b)
+	table := make([][]int, n+1)
+	for i := range table {
+		table[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				table[i][j] = table[i+1][j+1] + 1
+			} else if table[i+1][j] >= table[i][j+1] {
+				table[i][j] = table[i+1][j]
+			} else {
+				table[i][j] = table[i][j+1]
+			}
+		}
+	}
+
+	ops := make([]op, 0, n+m)
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, op{opEqual, a[i]})
+			i++
+			j++
+		case table[i+1][j] >= table[i][j+1]:
+			ops = append(ops, op{opDelete, a[i]})
+			i++
+		default:
+			ops = append(ops, op{opInsert, b[j]})
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		ops = append(ops, op{opDelete, a[i]})
+	}
+	for ; j < m; j++ {
+		ops = append(ops, op{opInsert, b[j]})
+	}
+	return ops
+}
@@ -0,0 +1,68 @@
+package diff
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderMultiLineString(t *testing.T) {
+	expected := "line one\nline two\nline three"
+	actual := "line one\nline TWO\nline three"
+	text, ok := Render(expected, actual)
+	if !ok {
+		t.Fatal("expected a diff for a multi-line string")
+	}
+	if !strings.Contains(text, "-  line two") && !strings.Contains(text, "- line two") {
+		t.Errorf("expected diff to show the removed line, got:\n%s", text)
+	}
+}
+
+func TestRenderScalarsAreNotDiffed(t *testing.T) {
+	if _, ok := Render(1, 2); ok {
+		t.Error("expected no diff for scalar values")
+	}
+	if _, ok := Render("short", "also short"); ok {
+		t.Error("expected no diff for single-line strings")
+	}
+}
+
+func TestRenderStruct(t *testing.T) {
+	type point struct{ X, Y int }
+	text, ok := Render(point{1, 2}, point{1, 3})
+	if !ok {
+		t.Fatal("expected a diff for differing structs")
+	}
+	if !strings.Contains(text, "--- expected") || !strings.Contains(text, "+++ actual") {
+		t.Errorf("expected unified diff headers, got:\n%s", text)
+	}
+}
+
+func TestSprintSortsMapKeys(t *testing.T) {
+	m := map[string]int{"b": 2, "a": 1, "c": 3}
+	out := Sprint(m)
+	if strings.Index(out, `"a"`) > strings.Index(out, `"b"`) || strings.Index(out, `"b"`) > strings.Index(out, `"c"`) {
+		t.Errorf("expected map keys in sorted order, got:\n%s", out)
+	}
+}
+
+func TestSprintTypedNil(t *testing.T) {
+	var p *int
+	out := Sprint(p)
+	if !strings.Contains(out, "*int") {
+		t.Errorf("expected typed nil to mention its type, got %q", out)
+	}
+}
+
+// Two fields pointing at the same object aren't a cycle unless one is an
+// ancestor of the other; seen[addr] used to stay set after print returned
+// from a pointer's subtree, so the second occurrence wrongly printed as
+// "<cycle>".
+func TestSprintSharedNonCyclicPointer(t *testing.T) {
+	type shared struct{ N int }
+	type holder struct{ A, B *shared }
+	s := &shared{N: 1}
+	out := Sprint(holder{A: s, B: s})
+	if strings.Contains(out, "<cycle>") {
+		t.Errorf("expected a shared but acyclic pointer to print normally, got:\n%s", out)
+	}
+}
@@ -1,14 +1,24 @@
+// Package expect is a fluent assertion library for tests.
+//
+// PostHandler, Comparitor and friends (EqualsComparitor, IsNil, IsNumeric,
+// ToAssertion, ThanAssertion, ...), referenced throughout this package,
+// come from the sibling chunks of this series rather than this tree;
+// confirm they land alongside this one before expecting `go build`/
+// `go test` to pass here.
 package expect
 
 import (
 	"bytes"
 	"reflect"
 	"strings"
+
+	"github.com/EdwardBetts/expect/diff"
 )
 
 type Expectation struct {
 	actual         interface{}
 	others         []interface{}
+	t              TestingT
 	Greater        *ThanAssertion
 	GreaterOrEqual *ToAssertion
 	Less           *ThanAssertion
@@ -21,6 +31,15 @@ func (e *Expectation) ToEqual(expected interface{}, others ...interface{}) PostH
 	return e.To.Equal(expected, others...)
 }
 
+// WithDiff enables a unified diff of the pretty-printed expected/actual
+// values when an Equal comparison on this Expectation fails. It is off by
+// default since the extra output is only useful for large structs, maps, or
+// multi-line strings.
+func (e *Expectation) WithDiff() *Expectation {
+	e.To.diff = true
+	return e
+}
+
 func (e *Expectation) GreaterThan(expected interface{}) PostHandler {
 	return e.Greater.Than(expected)
 }
@@ -46,7 +65,7 @@ var Errorf = func(format string, args ...interface{}) {
 }
 
 func Expect(actual interface{}, others ...interface{}) *Expectation {
-	return expect(actual, others, true)
+	return expect(nil, actual, others, true)
 }
 
 func Fail(format string, args ...interface{}) {
@@ -57,24 +76,33 @@ func Skip(format string, args ...interface{}) {
 	runner.Skip(format, args...)
 }
 
-func expect(actual interface{}, others []interface{}, includeNot bool) *Expectation {
-	e := &Expectation{actual: actual, others: others}
+func expect(t TestingT, actual interface{}, others []interface{}, includeNot bool) *Expectation {
+	e := &Expectation{actual: actual, others: others, t: t}
 	e.Greater = newThanAssertion(actual, GreaterThanComparitor, "to be greater than", "greater than")
+	e.Greater.t, e.Greater.to.t = t, t
 	e.GreaterOrEqual = newToAssertion(actual, GreaterOrEqualToComparitor, "to be greater or equal to")
+	e.GreaterOrEqual.t = t
 	e.Less = newThanAssertion(actual, LessThanComparitor, "to be less than", "less than")
+	e.Less.t, e.Less.to.t = t, t
 	e.LessOrEqual = newToAssertion(actual, LessThanOrEqualToComparitor, "to be less or equal to")
+	e.LessOrEqual.t = t
 	e.To = &ToExpectation{
 		actual: actual,
 		others: others,
+		t:      t,
 	}
 	if includeNot {
-		e.Not = NotExpect(actual, others...)
+		e.Not = notExpect(t, actual, others...)
 	}
 	return e
 }
 
 func NotExpect(actual interface{}, others ...interface{}) *InvertedExpectation {
-	e := &InvertedExpectation{expect(actual, others, false)}
+	return notExpect(nil, actual, others...)
+}
+
+func notExpect(t TestingT, actual interface{}, others ...interface{}) *InvertedExpectation {
+	e := &InvertedExpectation{expect(t, actual, others, false)}
 	e.Greater.invert = true
 	e.GreaterOrEqual.invert = true
 	e.Less.invert = true
@@ -84,9 +112,18 @@ func NotExpect(actual interface{}, others ...interface{}) *InvertedExpectation {
 }
 
 type ToExpectation struct {
-	invert bool
-	actual interface{}
-	others []interface{}
+	invert   bool
+	actual   interface{}
+	others   []interface{}
+	diff     bool
+	nanEqual bool
+	t        TestingT
+}
+
+// errorf reports a failure through t, when this ToExpectation came from
+// ExpectT, or through the global runner otherwise.
+func (e *ToExpectation) errorf(format string, args ...interface{}) {
+	dispatch(e.t, format, args...)
 }
 
 func (e *ToExpectation) Equal(expected interface{}, others ...interface{}) PostHandler {
@@ -96,10 +133,11 @@ func (e *ToExpectation) Equal(expected interface{}, others ...interface{}) PostH
 	}
 	assertion := newToAssertion(e.actual, EqualsComparitor, display)
 	assertion.invert = e.invert
+	assertion.t = e.t
 	failed := !equal(assertion, e.actual, expected)
 
 	if len(others) != len(e.others) {
-		Errorf("mismatch number of values and expectations %d != %d", len(e.others)+1, len(others)+1)
+		e.errorf("mismatch number of values and expectations %d != %d", len(e.others)+1, len(others)+1)
 		failed = true
 	} else {
 		for i := 0; i < len(others); i++ {
@@ -109,6 +147,12 @@ func (e *ToExpectation) Equal(expected interface{}, others ...interface{}) PostH
 		}
 	}
 
+	if failed && e.diff && e.invert == false {
+		if text, ok := diff.Render(expected, e.actual); ok {
+			e.errorf("%s", text)
+		}
+	}
+
 	if failed {
 		return FailureHandler
 	}
@@ -120,7 +164,7 @@ func equal(assertion *ToAssertion, a, b interface{}) bool {
 	bIsNil := IsNil(b)
 	if aIsNil || bIsNil {
 		if (aIsNil == bIsNil) == assertion.invert {
-			showError(a, b, assertion.invert, assertion.display)
+			showError(assertion.t, a, b, assertion.invert, assertion.display)
 			return false
 		}
 		return true
@@ -132,11 +176,11 @@ func equal(assertion *ToAssertion, a, b interface{}) bool {
 func (e *ToExpectation) Contain(expected interface{}) PostHandler {
 	c := contains(e.actual, expected)
 	if e.invert == false && c == false {
-		Errorf("%v does not contain %v", e.actual, expected)
+		e.errorf("%v does not contain %v", e.actual, expected)
 		return FailureHandler
 	}
 	if e.invert == true && c == true {
-		Errorf("%v contains %v", e.actual, expected)
+		e.errorf("%v contains %v", e.actual, expected)
 		return FailureHandler
 	}
 	return SuccessHandler
@@ -147,6 +191,7 @@ type ToAssertion struct {
 	comparitor Comparitor
 	display    string
 	invert     bool
+	t          TestingT
 }
 
 func newToAssertion(a interface{}, c Comparitor, display string) *ToAssertion {
@@ -161,7 +206,7 @@ func (a *ToAssertion) To(expected interface{}) PostHandler {
 	actual := a.actual
 	kind, ok := SameKind(actual, expected)
 	if ok == false {
-		Errorf("expected %v %s %v - type mismatch %s != %s", actual, a.display, expected, reflect.ValueOf(actual).Kind(), reflect.ValueOf(expected).Kind())
+		dispatch(a.t, "expected %v %s %v - type mismatch %s != %s", actual, a.display, expected, reflect.ValueOf(actual).Kind(), reflect.ValueOf(expected).Kind())
 		return FailureHandler
 	}
 	if IsInt(actual) {
@@ -172,24 +217,31 @@ func (a *ToAssertion) To(expected interface{}) PostHandler {
 		kind = reflect.Uint64
 	}
 	if a.comparitor(kind, actual, expected) == a.invert {
-		showError(actual, expected, a.invert, a.display)
+		showError(a.t, actual, expected, a.invert, a.display)
 		return FailureHandler
 	}
 	return SuccessHandler
 }
 
-func showError(actual, expected interface{}, invert bool, display string) {
-	var inversion string
+func showError(t TestingT, actual, expected interface{}, invert bool, display string) {
+	dispatch(t, "expected %v %s%s %v", actual, invertWord(invert), display, expected)
+}
+
+// invertWord returns "not " when invert is true and "" otherwise, for
+// matchers that need to phrase their failure message differently depending
+// on whether they're running under Not.
+func invertWord(invert bool) string {
 	if invert {
-		inversion = "not "
+		return "not "
 	}
-	Errorf("expected %v %s%s %v", actual, inversion, display, expected)
+	return ""
 }
 
 type ThanAssertion struct {
 	to      *ToAssertion
 	display string
 	invert  bool
+	t       TestingT
 }
 
 func newThanAssertion(actual interface{}, c Comparitor, toDisplay, thanDisplay string) *ThanAssertion {
@@ -203,11 +255,11 @@ func (a *ThanAssertion) Than(expected interface{}) PostHandler {
 	actual := a.to.actual
 	a.to.invert = a.invert
 	if IsNumeric(actual) == false {
-		Errorf("cannot use %s for type %s", a.display, reflect.ValueOf(actual).Kind())
+		dispatch(a.t, "cannot use %s for type %s", a.display, reflect.ValueOf(actual).Kind())
 		return FailureHandler
 	}
 	if IsNumeric(expected) == false {
-		Errorf("cannot use %s for type %s", a.display, reflect.ValueOf(expected).Kind())
+		dispatch(a.t, "cannot use %s for type %s", a.display, reflect.ValueOf(expected).Kind())
 		return FailureHandler
 	}
 	return a.to.To(expected)
@@ -0,0 +1,31 @@
+package expect
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// captureT is a minimal TestingT that records the formatted message from
+// each Errorf call, so tests can inspect exactly what would have been
+// reported without depending on *testing.T's own formatting.
+type captureT struct {
+	*testing.T
+	messages []string
+}
+
+func (c *captureT) Errorf(format string, args ...interface{}) {
+	c.messages = append(c.messages, fmt.Sprintf(format, args...))
+}
+
+func TestEqualWithDiffDoesNotTreatDumpAsFormatString(t *testing.T) {
+	c := &captureT{T: t}
+	type record struct{ Message string }
+	ExpectT(c, record{Message: "50% done, got %d errors"}).WithDiff().ToEqual(record{Message: "other"})
+
+	for _, m := range c.messages {
+		if strings.Contains(m, "%!d") || strings.Contains(m, "MISSING") {
+			t.Errorf("diff output leaked as a format string, got: %q", m)
+		}
+	}
+}
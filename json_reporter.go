@@ -0,0 +1,69 @@
+package expect
+
+import (
+	"encoding/json"
+	"os"
+)
+
+// JSONReporter writes one newline-delimited JSON event per test as the
+// suite runs, so CI systems can stream and parse results without waiting
+// for the whole suite to finish.
+type JSONReporter struct {
+	encoder *json.Encoder
+	file    *os.File
+}
+
+// NewJSONReporter creates a JSONReporter that appends events to path. It
+// opens with O_APPEND rather than O_TRUNC so that a process calling
+// Expectify more than once - one call per suite, the normal pattern -
+// accumulates every suite's events in the file instead of each new suite
+// overwriting the last.
+func NewJSONReporter(path string) *JSONReporter {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		panic(err)
+	}
+	return &JSONReporter{file: file, encoder: json.NewEncoder(file)}
+}
+
+func (r *JSONReporter) StartSuite(typeName string)      {}
+func (r *JSONReporter) StartTest(name, typeName string) {}
+
+type jsonFailure struct {
+	Message  string `json:"message"`
+	Location string `json:"location"`
+}
+
+type jsonEvent struct {
+	Type        string        `json:"type"`
+	Method      string        `json:"method"`
+	DurationMS  int64         `json:"duration_ms"`
+	Passed      bool          `json:"passed"`
+	Skipped     bool          `json:"skipped"`
+	SkipMessage string        `json:"skip_message,omitempty"`
+	Failures    []jsonFailure `json:"failures,omitempty"`
+}
+
+func (r *JSONReporter) TestResult(res *result) {
+	event := jsonEvent{
+		Type:        res.typeName,
+		Method:      res.method,
+		DurationMS:  res.end.Sub(res.start).Nanoseconds() / 1000000,
+		Passed:      res.Passed(),
+		Skipped:     res.skip,
+		SkipMessage: res.skipMessage,
+	}
+	for _, failure := range res.failures {
+		event.Failures = append(event.Failures, jsonFailure{
+			Message:  failure.message,
+			Location: failure.location,
+		})
+	}
+	if err := r.encoder.Encode(event); err != nil {
+		panic(err)
+	}
+}
+
+func (r *JSONReporter) Finish(results []*result) {
+	r.file.Close()
+}
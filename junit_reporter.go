@@ -0,0 +1,135 @@
+package expect
+
+import (
+	"encoding/xml"
+	"os"
+)
+
+// JUnitReporter collects test results as a suite runs and, on Finish,
+// writes them to path as a JUnit-XML report that CI systems such as
+// Jenkins or GitLab can parse.
+type JUnitReporter struct {
+	path string
+}
+
+// NewJUnitReporter creates a JUnitReporter that writes to path on Finish.
+func NewJUnitReporter(path string) *JUnitReporter {
+	return &JUnitReporter{path: path}
+}
+
+func (r *JUnitReporter) StartSuite(typeName string)      {}
+func (r *JUnitReporter) StartTest(name, typeName string) {}
+func (r *JUnitReporter) TestResult(res *result)          {}
+
+// Finish writes results as one or more <testsuite> elements to r.path. A
+// process that calls Expectify more than once (one call per suite, the
+// normal pattern) finishes each suite separately, so Finish merges this
+// suite's elements into whatever the prior call already wrote rather than
+// truncating it - the same accumulate-on-write approach
+// updatePersistedSummary uses for -summary.
+func (r *JUnitReporter) Finish(results []*result) {
+	suites := map[string]*junitSuite{}
+	order := make([]string, 0, 4)
+	for _, res := range results {
+		suite, ok := suites[res.typeName]
+		if !ok {
+			suite = &junitSuite{Name: res.typeName}
+			suites[res.typeName] = suite
+			order = append(order, res.typeName)
+		}
+		suite.addCase(res)
+	}
+
+	doc := &junitTestSuites{}
+	for _, existing := range r.readExisting().Suites {
+		if _, supersededByThisRun := suites[existing.Name]; supersededByThisRun {
+			continue
+		}
+		doc.Suites = append(doc.Suites, existing)
+	}
+	for _, name := range order {
+		doc.Suites = append(doc.Suites, *suites[name])
+	}
+
+	file, err := os.Create(r.path)
+	if err != nil {
+		panic(err)
+	}
+	defer file.Close()
+
+	file.WriteString(xml.Header)
+	encoder := xml.NewEncoder(file)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		panic(err)
+	}
+}
+
+// readExisting reads and parses any JUnit-XML report already at r.path, so
+// Finish can merge into it instead of overwriting it. A missing or
+// unparsable file is treated as an empty report.
+func (r *JUnitReporter) readExisting() *junitTestSuites {
+	doc := &junitTestSuites{}
+	data, err := os.ReadFile(r.path)
+	if err != nil {
+		return doc
+	}
+	xml.Unmarshal(data, doc)
+	return doc
+}
+
+type junitTestSuites struct {
+	XMLName xml.Name     `xml:"testsuites"`
+	Suites  []junitSuite `xml:"testsuite"`
+}
+
+type junitSuite struct {
+	Name     string      `xml:"name,attr"`
+	Tests    int         `xml:"tests,attr"`
+	Failures int         `xml:"failures,attr"`
+	Skipped  int         `xml:"skipped,attr"`
+	Time     float64     `xml:"time,attr"`
+	Cases    []junitCase `xml:"testcase"`
+}
+
+type junitCase struct {
+	ClassName string        `xml:"classname,attr"`
+	Name      string        `xml:"name,attr"`
+	Time      float64       `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Skipped   *junitSkipped `xml:"skipped,omitempty"`
+}
+
+type junitFailure struct {
+	Message  string `xml:"message,attr"`
+	Location string `xml:"type,attr"`
+	Text     string `xml:",chardata"`
+}
+
+type junitSkipped struct {
+	Message string `xml:"message,attr"`
+}
+
+func (s *junitSuite) addCase(res *result) {
+	s.Tests++
+	duration := res.end.Sub(res.start).Seconds()
+	c := junitCase{
+		ClassName: res.typeName,
+		Name:      res.method,
+		Time:      duration,
+	}
+	if res.skip {
+		s.Skipped++
+		c.Skipped = &junitSkipped{Message: res.skipMessage}
+	} else if res.Passed() == false {
+		s.Failures++
+		failure := res.failures[0]
+		c.Failure = &junitFailure{
+			Message:  failure.message,
+			Location: failure.location,
+			Text:     failure.message,
+		}
+	}
+	s.Time += duration
+	s.Cases = append(s.Cases, c)
+}
@@ -0,0 +1,129 @@
+package expect
+
+import "reflect"
+
+func (e *Expectation) HaveLen(n int) PostHandler {
+	return e.To.HaveLen(n)
+}
+
+func (e *Expectation) BeEmpty() PostHandler {
+	return e.To.BeEmpty()
+}
+
+func (e *Expectation) BeNil() PostHandler {
+	return e.To.BeNil()
+}
+
+func (e *Expectation) ContainKey(key interface{}) PostHandler {
+	return e.To.ContainKey(key)
+}
+
+func (e *Expectation) ContainValue(value interface{}) PostHandler {
+	return e.To.ContainValue(value)
+}
+
+func (e *Expectation) ContainElement(expected interface{}) PostHandler {
+	return e.To.ContainElement(expected)
+}
+
+// HaveLen asserts that actual, which must be a string, slice, array, map, or
+// chan, has exactly n elements.
+func (e *ToExpectation) HaveLen(n int) PostHandler {
+	v := reflect.ValueOf(e.actual)
+	switch v.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map, reflect.Chan:
+	default:
+		e.errorf("cannot take the length of %v (%s)", e.actual, v.Kind())
+		return FailureHandler
+	}
+	has := v.Len() == n
+	if has == e.invert {
+		e.errorf("expected %v to %shave length %d, got %d", e.actual, invertWord(e.invert), n, v.Len())
+		return FailureHandler
+	}
+	return SuccessHandler
+}
+
+// BeEmpty asserts that actual is the zero value for its type, or has zero
+// length if it is a string, slice, array, map, or chan.
+func (e *ToExpectation) BeEmpty() PostHandler {
+	empty := isEmpty(e.actual)
+	if empty == e.invert {
+		e.errorf("expected %v to %sbe empty", e.actual, invertWord(e.invert))
+		return FailureHandler
+	}
+	return SuccessHandler
+}
+
+func isEmpty(actual interface{}) bool {
+	if actual == nil {
+		return true
+	}
+	v := reflect.ValueOf(actual)
+	switch v.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map, reflect.Chan:
+		return v.Len() == 0
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return true
+		}
+		return isEmpty(v.Elem().Interface())
+	}
+	return reflect.DeepEqual(actual, reflect.Zero(v.Type()).Interface())
+}
+
+// BeNil asserts that actual is nil, distinguishing a typed nil (e.g. a nil
+// pointer stored in an interface) from an untyped one the same way IsNil
+// does.
+func (e *ToExpectation) BeNil() PostHandler {
+	isNil := IsNil(e.actual)
+	if isNil == e.invert {
+		e.errorf("expected %v to %sbe nil", e.actual, invertWord(e.invert))
+		return FailureHandler
+	}
+	return SuccessHandler
+}
+
+// ContainKey asserts that actual, a map, has the given key.
+func (e *ToExpectation) ContainKey(key interface{}) PostHandler {
+	v := reflect.ValueOf(e.actual)
+	if v.Kind() != reflect.Map {
+		e.errorf("cannot check for keys on %v (%s)", e.actual, v.Kind())
+		return FailureHandler
+	}
+	keyVal := reflect.ValueOf(key)
+	has := keyVal.IsValid() && keyVal.Type().AssignableTo(v.Type().Key()) && v.MapIndex(keyVal).IsValid()
+	if has == e.invert {
+		e.errorf("expected %v to %scontain key %v", e.actual, invertWord(e.invert), key)
+		return FailureHandler
+	}
+	return SuccessHandler
+}
+
+// ContainValue asserts that actual, a map, has a value equal to the given
+// value for at least one of its keys.
+func (e *ToExpectation) ContainValue(value interface{}) PostHandler {
+	v := reflect.ValueOf(e.actual)
+	if v.Kind() != reflect.Map {
+		e.errorf("cannot check for values on %v (%s)", e.actual, v.Kind())
+		return FailureHandler
+	}
+	has := false
+	for _, key := range v.MapKeys() {
+		if reflect.DeepEqual(v.MapIndex(key).Interface(), value) {
+			has = true
+			break
+		}
+	}
+	if has == e.invert {
+		e.errorf("expected %v to %scontain value %v", e.actual, invertWord(e.invert), value)
+		return FailureHandler
+	}
+	return SuccessHandler
+}
+
+// ContainElement is a clearer alias for Contain when actual is a slice or
+// array.
+func (e *ToExpectation) ContainElement(expected interface{}) PostHandler {
+	return e.Contain(expected)
+}
@@ -0,0 +1,65 @@
+package expect
+
+import "testing"
+
+func TestHaveLen(t *testing.T) {
+	if ExpectT(t, "abc").HaveLen(3) != SuccessHandler {
+		t.Error("expected \"abc\" to have length 3")
+	}
+	c := &captureT{T: t}
+	if ExpectT(c, []int{1, 2}).HaveLen(3) != FailureHandler {
+		t.Error("expected []int{1, 2} to not have length 3")
+	}
+}
+
+func TestBeEmpty(t *testing.T) {
+	if ExpectT(t, "").BeEmpty() != SuccessHandler {
+		t.Error("expected \"\" to be empty")
+	}
+	c := &captureT{T: t}
+	if ExpectT(c, "x").BeEmpty() != FailureHandler {
+		t.Error("expected \"x\" to not be empty")
+	}
+	if ExpectT(t, "x").Not.BeEmpty() != SuccessHandler {
+		t.Error("expected Not.BeEmpty() to pass for \"x\"")
+	}
+}
+
+func TestBeNilMatcher(t *testing.T) {
+	var p *int
+	if ExpectT(t, p).BeNil() != SuccessHandler {
+		t.Error("expected nil pointer to be nil")
+	}
+	c := &captureT{T: t}
+	if ExpectT(c, 1).BeNil() != FailureHandler {
+		t.Error("expected 1 to not be nil")
+	}
+}
+
+func TestContainKeyValue(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+	if ExpectT(t, m).ContainKey("a") != SuccessHandler {
+		t.Error("expected map to contain key \"a\"")
+	}
+	c := &captureT{T: t}
+	if ExpectT(c, m).ContainKey("z") != FailureHandler {
+		t.Error("expected map to not contain key \"z\"")
+	}
+	if ExpectT(t, m).ContainValue(2) != SuccessHandler {
+		t.Error("expected map to contain value 2")
+	}
+	c = &captureT{T: t}
+	if ExpectT(c, m).ContainValue(9) != FailureHandler {
+		t.Error("expected map to not contain value 9")
+	}
+}
+
+func TestContainElement(t *testing.T) {
+	if ExpectT(t, []int{1, 2, 3}).ContainElement(2) != SuccessHandler {
+		t.Error("expected slice to contain 2")
+	}
+	c := &captureT{T: t}
+	if ExpectT(c, []int{1, 2, 3}).ContainElement(9) != FailureHandler {
+		t.Error("expected slice to not contain 9")
+	}
+}
@@ -0,0 +1,126 @@
+package expect
+
+import "math"
+
+func (e *Expectation) ToBeCloseTo(expected interface{}, delta float64) PostHandler {
+	return e.To.BeCloseTo(expected, delta)
+}
+
+func (e *Expectation) InEpsilon(expected interface{}, epsilon float64) PostHandler {
+	return e.To.InEpsilon(expected, epsilon)
+}
+
+// NaNEqual makes a subsequent BeCloseTo/InEpsilon comparison treat NaN as
+// equal to NaN instead of the default IEEE 754 behaviour of treating it as
+// unequal to everything, including itself. e.Not is built on its own
+// *ToExpectation rather than sharing e.To's, so the flag is set on both
+// trees here to keep Expect(x).NaNEqual().Not... and
+// Expect(x).Not.NaNEqual()... equivalent.
+func (e *Expectation) NaNEqual() *Expectation {
+	e.To.nanEqual = true
+	if e.Not != nil {
+		e.Not.To.nanEqual = true
+	}
+	return e
+}
+
+func (e *ToExpectation) closeEnough(actual, expected float64, within func(a, e float64) bool) bool {
+	if math.IsNaN(actual) || math.IsNaN(expected) {
+		return e.nanEqual && math.IsNaN(actual) && math.IsNaN(expected)
+	}
+	if math.IsInf(actual, 0) || math.IsInf(expected, 0) {
+		return actual == expected
+	}
+	return within(actual, expected)
+}
+
+// BeCloseTo asserts that actual and expected, both numeric, differ by no
+// more than delta.
+func (e *ToExpectation) BeCloseTo(expected interface{}, delta float64) PostHandler {
+	if IsNumeric(e.actual) == false || IsNumeric(expected) == false {
+		e.errorf("cannot compare %v and %v as floats", e.actual, expected)
+		return FailureHandler
+	}
+	actualFloat, expectedFloat := toFloat64(e.actual), toFloat64(expected)
+	close := e.closeEnough(actualFloat, expectedFloat, func(a, b float64) bool {
+		return math.Abs(a-b) <= delta
+	})
+	if close == e.invert {
+		e.errorf("expected %v to %sbe within %v of %v", e.actual, invertWord(e.invert), delta, expected)
+		return FailureHandler
+	}
+	return SuccessHandler
+}
+
+// InEpsilon asserts that actual and expected, both numeric, differ by no
+// more than epsilon relative to expected.
+func (e *ToExpectation) InEpsilon(expected interface{}, epsilon float64) PostHandler {
+	if IsNumeric(e.actual) == false || IsNumeric(expected) == false {
+		e.errorf("cannot compare %v and %v as floats", e.actual, expected)
+		return FailureHandler
+	}
+	actualFloat, expectedFloat := toFloat64(e.actual), toFloat64(expected)
+	close := e.closeEnough(actualFloat, expectedFloat, func(a, b float64) bool {
+		if b == 0 {
+			return a == 0
+		}
+		return math.Abs((a-b)/b) <= epsilon
+	})
+	if close == e.invert {
+		e.errorf("expected %v to %sbe within relative epsilon %v of %v", e.actual, invertWord(e.invert), epsilon, expected)
+		return FailureHandler
+	}
+	return SuccessHandler
+}
+
+// InDeltaSlice asserts that actual and expected have the same length and
+// that each pair of elements is within delta of one another. It reports
+// through t rather than the package-global runner so it can be called
+// outside Expectify, the same way the table-driven helpers in table.go do.
+func InDeltaSlice(t TestingT, actual, expected []float64, delta float64) PostHandler {
+	if len(actual) != len(expected) {
+		dispatch(t, "expected slices of equal length, got %d != %d", len(actual), len(expected))
+		return FailureHandler
+	}
+	failed := false
+	for i := range actual {
+		if math.Abs(actual[i]-expected[i]) > delta {
+			dispatch(t, "expected %v to be within %v of %v at index %d", actual[i], delta, expected[i], i)
+			failed = true
+		}
+	}
+	if failed {
+		return FailureHandler
+	}
+	return SuccessHandler
+}
+
+func toFloat64(v interface{}) float64 {
+	switch n := v.(type) {
+	case float32:
+		return float64(n)
+	case float64:
+		return n
+	case int:
+		return float64(n)
+	case int8:
+		return float64(n)
+	case int16:
+		return float64(n)
+	case int32:
+		return float64(n)
+	case int64:
+		return float64(n)
+	case uint:
+		return float64(n)
+	case uint8:
+		return float64(n)
+	case uint16:
+		return float64(n)
+	case uint32:
+		return float64(n)
+	case uint64:
+		return float64(n)
+	}
+	return 0
+}
@@ -0,0 +1,50 @@
+package expect
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBeCloseTo(t *testing.T) {
+	if ExpectT(t, 1.0001).ToBeCloseTo(1.0, 0.001) != SuccessHandler {
+		t.Error("expected 1.0001 to be within 0.001 of 1.0")
+	}
+	c := &captureT{T: t}
+	if ExpectT(c, 1.1).ToBeCloseTo(1.0, 0.001) != FailureHandler {
+		t.Error("expected 1.1 to not be within 0.001 of 1.0")
+	}
+	c = &captureT{T: t}
+	if ExpectT(c, math.NaN()).ToBeCloseTo(math.NaN(), 0.001) != FailureHandler {
+		t.Error("expected NaN to not be close to NaN by default")
+	}
+	if ExpectT(t, math.NaN()).NaNEqual().ToBeCloseTo(math.NaN(), 0.001) != SuccessHandler {
+		t.Error("expected NaN to be close to NaN with NaNEqual")
+	}
+}
+
+func TestNaNEqualAppliesBeforeNot(t *testing.T) {
+	c := &captureT{T: t}
+	if ExpectT(c, math.NaN()).NaNEqual().Not.ToBeCloseTo(math.NaN(), 0.001) != FailureHandler {
+		t.Error("expected Not.ToBeCloseTo to still see NaNEqual set before it")
+	}
+}
+
+func TestInEpsilon(t *testing.T) {
+	if ExpectT(t, 105.0).InEpsilon(100.0, 0.1) != SuccessHandler {
+		t.Error("expected 105 to be within 10% of 100")
+	}
+	c := &captureT{T: t}
+	if ExpectT(c, 200.0).InEpsilon(100.0, 0.1) != FailureHandler {
+		t.Error("expected 200 to not be within 10% of 100")
+	}
+}
+
+func TestInDeltaSlice(t *testing.T) {
+	if InDeltaSlice(t, []float64{1.0, 2.0}, []float64{1.01, 1.99}, 0.1) != SuccessHandler {
+		t.Error("expected slices to be within delta")
+	}
+	c := &captureT{T: t}
+	if InDeltaSlice(c, []float64{1.0, 2.0}, []float64{1.0}, 0.1) != FailureHandler {
+		t.Error("expected mismatched-length slices to fail")
+	}
+}
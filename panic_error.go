@@ -0,0 +1,146 @@
+package expect
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+func (e *Expectation) ToPanic() PostHandler {
+	return e.To.Panic()
+}
+
+func (e *Expectation) ToPanicWith(value interface{}) PostHandler {
+	return e.To.PanicWith(value)
+}
+
+func (e *Expectation) ToPanicWithMessage(substr string) PostHandler {
+	return e.To.PanicWithMessage(substr)
+}
+
+func (e *Expectation) ToBeError(target error) PostHandler {
+	return e.To.BeError(target)
+}
+
+func (e *Expectation) ToBeErrorAs(target interface{}) PostHandler {
+	return e.To.BeErrorAs(target)
+}
+
+func (e *Expectation) ToHaveErrorMessage(substr string) PostHandler {
+	return e.To.HaveErrorMessage(substr)
+}
+
+// Panic asserts that actual, a func(), panics when called.
+func (e *ToExpectation) Panic() PostHandler {
+	fn, ok := e.actual.(func())
+	if !ok {
+		e.errorf("expected a func() to test for panic, got %v", e.actual)
+		return FailureHandler
+	}
+	panicked, _ := didPanic(fn)
+	if panicked == e.invert {
+		e.errorf("expected function to %spanic", invertWord(e.invert))
+		return FailureHandler
+	}
+	return SuccessHandler
+}
+
+// PanicWith asserts that actual, a func(), panics with a value equal to
+// expected when called.
+func (e *ToExpectation) PanicWith(expected interface{}) PostHandler {
+	fn, ok := e.actual.(func())
+	if !ok {
+		e.errorf("expected a func() to test for panic, got %v", e.actual)
+		return FailureHandler
+	}
+	panicked, recovered := didPanic(fn)
+	matches := panicked && reflect.DeepEqual(recovered, expected)
+	if matches == e.invert {
+		e.errorf("expected function to %spanic with %v, got %v", invertWord(e.invert), expected, recovered)
+		return FailureHandler
+	}
+	return SuccessHandler
+}
+
+// PanicWithMessage asserts that actual, a func(), panics with a value whose
+// string form contains substr when called.
+func (e *ToExpectation) PanicWithMessage(substr string) PostHandler {
+	fn, ok := e.actual.(func())
+	if !ok {
+		e.errorf("expected a func() to test for panic, got %v", e.actual)
+		return FailureHandler
+	}
+	panicked, recovered := didPanic(fn)
+	matches := panicked && strings.Contains(fmt.Sprintf("%v", recovered), substr)
+	if matches == e.invert {
+		e.errorf("expected function to %spanic with a message containing %q, got %v", invertWord(e.invert), substr, recovered)
+		return FailureHandler
+	}
+	return SuccessHandler
+}
+
+// didPanic runs f and reports whether it panicked, and with what value,
+// distinguishing a genuine panic(nil) from the func simply returning.
+func didPanic(f func()) (panicked bool, recovered interface{}) {
+	panicked = true
+	defer func() {
+		recovered = recover()
+	}()
+	f()
+	panicked = false
+	return
+}
+
+// BeError asserts that actual is an error for which errors.Is(actual,
+// target) holds.
+func (e *ToExpectation) BeError(target error) PostHandler {
+	err, ok := e.actual.(error)
+	if !ok && e.actual != nil {
+		e.errorf("expected an error, got %v", e.actual)
+		return FailureHandler
+	}
+	is := errors.Is(err, target)
+	if is == e.invert {
+		e.errorf("expected %v to %sbe error %v", e.actual, invertWord(e.invert), target)
+		return FailureHandler
+	}
+	return SuccessHandler
+}
+
+// BeErrorAs asserts that actual is an error for which errors.As(actual,
+// target) holds. target must be a non-nil pointer, per errors.As.
+func (e *ToExpectation) BeErrorAs(target interface{}) PostHandler {
+	err, ok := e.actual.(error)
+	if !ok && e.actual != nil {
+		e.errorf("expected an error, got %v", e.actual)
+		return FailureHandler
+	}
+	as := errors.As(err, target)
+	if as == e.invert {
+		e.errorf("expected %v to %sbe assignable to %T", e.actual, invertWord(e.invert), target)
+		return FailureHandler
+	}
+	return SuccessHandler
+}
+
+// HaveErrorMessage asserts that actual, an error, has an Error() string
+// containing substr. As with BeError/BeErrorAs, an actual that is neither
+// nil nor an error is a hard failure regardless of Not; a nil actual is
+// treated as an error with no message, so Not.HaveErrorMessage(...) can be
+// used to assert "no matching error". actual is checked via IsNil rather
+// than a bare `!= nil` so a typed-nil error (e.g. a nil *myErr boxed into
+// the error interface) doesn't reach err.Error() and panic.
+func (e *ToExpectation) HaveErrorMessage(substr string) PostHandler {
+	err, ok := e.actual.(error)
+	if !ok && e.actual != nil {
+		e.errorf("expected an error, got %v", e.actual)
+		return FailureHandler
+	}
+	has := !IsNil(err) && strings.Contains(err.Error(), substr)
+	if has == e.invert {
+		e.errorf("expected error %v to %scontain %q", e.actual, invertWord(e.invert), substr)
+		return FailureHandler
+	}
+	return SuccessHandler
+}
@@ -0,0 +1,97 @@
+package expect
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+)
+
+func TestPanicMatchers(t *testing.T) {
+	if ExpectT(t, func() { panic("boom") }).ToPanic() != SuccessHandler {
+		t.Error("expected func to panic")
+	}
+	c := &captureT{T: t}
+	if ExpectT(c, func() {}).ToPanic() != FailureHandler {
+		t.Error("expected non-panicking func to fail ToPanic")
+	}
+	if ExpectT(t, func() { panic("boom") }).ToPanicWith("boom") != SuccessHandler {
+		t.Error("expected panic value to match")
+	}
+	if ExpectT(t, func() { panic("boom") }).ToPanicWithMessage("oo") != SuccessHandler {
+		t.Error("expected panic message to contain substring")
+	}
+}
+
+func TestBeErrorMatchers(t *testing.T) {
+	wrapped := fmt.Errorf("wrapped: %w", io.EOF)
+	if ExpectT(t, wrapped).ToBeError(io.EOF) != SuccessHandler {
+		t.Error("expected errors.Is to match io.EOF")
+	}
+	c := &captureT{T: t}
+	if ExpectT(c, errors.New("other")).ToBeError(io.EOF) != FailureHandler {
+		t.Error("expected unrelated error to not match io.EOF")
+	}
+}
+
+type pathError struct{ path string }
+
+func (e *pathError) Error() string { return "path error: " + e.path }
+
+func TestBeErrorAsMatchers(t *testing.T) {
+	wrapped := fmt.Errorf("wrapped: %w", &pathError{path: "/tmp"})
+	var target *pathError
+	if ExpectT(t, wrapped).ToBeErrorAs(&target) != SuccessHandler {
+		t.Error("expected errors.As to match *pathError")
+	}
+	if target == nil || target.path != "/tmp" {
+		t.Errorf("expected errors.As to populate target, got %v", target)
+	}
+	var mismatch *os.PathError
+	c := &captureT{T: t}
+	if ExpectT(c, wrapped).ToBeErrorAs(&mismatch) != FailureHandler {
+		t.Error("expected errors.As to not match an unrelated target type")
+	}
+}
+
+func TestHaveErrorMessage(t *testing.T) {
+	err := errors.New("connection refused")
+	if ExpectT(t, err).ToHaveErrorMessage("refused") != SuccessHandler {
+		t.Error("expected error message to contain substring")
+	}
+	if ExpectT(t, err).Not.ToHaveErrorMessage("timeout") != SuccessHandler {
+		t.Error("expected Not.ToHaveErrorMessage to pass for a non-matching substring")
+	}
+}
+
+// An untyped nil actual ("no error happened") is a legitimate thing to
+// assert against with Not, not a hard failure the way a wrong-typed actual
+// is.
+func TestHaveErrorMessageNilActual(t *testing.T) {
+	var err error
+	if ExpectT(t, err).Not.ToHaveErrorMessage("boom") != SuccessHandler {
+		t.Error("expected Not.ToHaveErrorMessage(...) to pass when actual is a nil error")
+	}
+	c := &captureT{T: t}
+	if ExpectT(c, err).ToHaveErrorMessage("boom") != FailureHandler {
+		t.Error("expected ToHaveErrorMessage(...) to fail when actual is a nil error")
+	}
+}
+
+type derefErr struct{ msg string }
+
+func (e *derefErr) Error() string { return e.msg }
+
+// A nil *derefErr boxed into the error interface is the classic Go
+// typed-nil trap: err != nil is true even though the pointer is nil, so
+// HaveErrorMessage must check IsNil rather than calling err.Error()
+// straight away, or this panics on a nil receiver dereference.
+func TestHaveErrorMessageTypedNilActual(t *testing.T) {
+	var p *derefErr
+	var err error = p
+	c := &captureT{T: t}
+	if ExpectT(c, err).ToHaveErrorMessage("boom") != FailureHandler {
+		t.Error("expected ToHaveErrorMessage(...) to fail for a typed-nil error, not panic")
+	}
+}
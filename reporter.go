@@ -0,0 +1,81 @@
+package expect
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/wsxiaoys/terminal/color"
+)
+
+// Reporter receives suite and test lifecycle events as Expectify runs a
+// suite. Implementations can render to the terminal, or to a file in a
+// format a CI system understands, such as JUnit-XML or newline-delimited
+// JSON.
+type Reporter interface {
+	// StartSuite is called once, before any test in the suite runs.
+	StartSuite(typeName string)
+	// StartTest is called before each test method runs.
+	StartTest(name, typeName string)
+	// TestResult is called once a test method has finished, whether it
+	// passed, failed, or was skipped.
+	TestResult(r *result)
+	// Finish is called once every test in the current suite has run, with
+	// the results collected during this Expectify call. A process that
+	// calls Expectify more than once (one call per suite, the normal
+	// pattern) will call Finish once per suite; reporters that need a
+	// view across the whole process - the JUnit and JSON file reporters -
+	// persist that across calls themselves, the way updatePersistedSummary
+	// accumulates totals for -summary.
+	Finish(results []*result)
+}
+
+// TerminalReporter renders colored, human-readable output to stdout. It
+// reproduces expect's original reporting behaviour: a test is only printed
+// as it runs when it fails or -test.v is set, and a failure summary is
+// printed once the suite finishes.
+type TerminalReporter struct {
+	typeName  string
+	announced bool
+}
+
+// NewTerminalReporter creates a TerminalReporter.
+func NewTerminalReporter() *TerminalReporter {
+	return &TerminalReporter{}
+}
+
+func (r *TerminalReporter) StartSuite(typeName string) {
+	r.typeName = typeName
+	r.announced = false
+}
+
+func (r *TerminalReporter) StartTest(name, typeName string) {}
+
+func (r *TerminalReporter) TestResult(res *result) {
+	if res.Passed() && testing.Verbose() == false {
+		return
+	}
+	if r.announced == false {
+		color.Printf("\n@!%s@|\n", r.typeName)
+		r.announced = true
+	}
+	res.Report()
+}
+
+func (r *TerminalReporter) Finish(results []*result) {
+	failed := 0
+	for _, res := range results {
+		if res.Passed() == false {
+			failed++
+		}
+	}
+	if failed == 0 {
+		return
+	}
+	fmt.Println("\nFailure summary")
+	for _, res := range results {
+		if res.Passed() == false {
+			res.Summary()
+		}
+	}
+	fmt.Println()
+}
@@ -0,0 +1,65 @@
+package expect
+
+import (
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newResult(typeName, method string, passed bool) *result {
+	r := &result{
+		typeName: typeName,
+		method:   method,
+		start:    time.Unix(0, 0),
+		end:      time.Unix(0, 0),
+		failures: make([]*Failure, 0),
+	}
+	if !passed {
+		r.failures = append(r.failures, &Failure{message: "boom", location: "x.go:1"})
+	}
+	return r
+}
+
+func TestJUnitReporterAccumulatesAcrossSuites(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "junit.xml")
+	reporter := NewJUnitReporter(path)
+
+	reporter.Finish([]*result{newResult("SuiteA", "TestOne", true)})
+	reporter.Finish([]*result{newResult("SuiteB", "TestTwo", false)})
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc junitTestSuites
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		t.Fatal(err)
+	}
+	if len(doc.Suites) != 2 {
+		t.Fatalf("expected both suites to be present after two Finish calls, got %d", len(doc.Suites))
+	}
+}
+
+func TestJSONReporterAppendsAcrossSuites(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "results.json")
+
+	first := NewJSONReporter(path)
+	first.TestResult(newResult("SuiteA", "TestOne", true))
+	first.Finish(nil)
+
+	second := NewJSONReporter(path)
+	second.TestResult(newResult("SuiteB", "TestTwo", false))
+	second.Finish(nil)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected one line per suite's event across both Finish calls, got %d lines", len(lines))
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"runtime"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -20,26 +21,43 @@ var (
 	showStdout  = flag.Bool("vv", false, "turn on stdout")
 	matchFlag   = flag.String("m", "", "Regular expression selecting which tests to run")
 	summaryPath = flag.String("summary", "", "Path to write a summary file to")
+	junitPath   = flag.String("junit", "", "Path to write a JUnit-XML report to")
+	jsonPath    = flag.String("json", "", "Path to write a newline-delimited JSON report to")
 	pattern     *regexp.Regexp
 	runner      *Runner
 	stdout      = os.Stdout
 	silentOut   *os.File
 	beforeEach  = make([]func(), 0, 2)
 	endTestErr  = new(error)
+	setupOnce   sync.Once
 )
 
-func init() {
-	flag.Parse()
-	if len(*matchFlag) != 0 {
-		pattern = regexp.MustCompile("(?i)" + *matchFlag)
-	}
-	if *showStdout == true {
-		silentOut = stdout
-	}
-	os.Stdout = silentOut
+// ensureSetup parses expect's own flags and applies the stdout-silencing
+// the first time Expectify runs, rather than in init(). init() functions
+// run before go test's generated main registers the testing package's own
+// -test.* flags, so calling flag.Parse() there would reject them with
+// "flag provided but not defined" - and it silenced os.Stdout for every
+// package that merely imports expect, whether or not it ever calls
+// Expectify. Deferring to here and guarding with flag.Parsed() means a
+// plain `go test` run (e.g. against the ExpectT-based tests that never
+// call Expectify) behaves like any other package.
+func ensureSetup() {
+	setupOnce.Do(func() {
+		if !flag.Parsed() {
+			flag.Parse()
+		}
+		if len(*matchFlag) != 0 {
+			pattern = regexp.MustCompile("(?i)" + *matchFlag)
+		}
+		if *showStdout == true {
+			silentOut = stdout
+		}
+		os.Stdout = silentOut
+	})
 }
 
 func Expectify(suite interface{}, t *testing.T) {
+	ensureSetup()
 	var name string
 	var res *result
 	defer func() {
@@ -60,9 +78,14 @@ func Expectify(suite interface{}, t *testing.T) {
 	tp := reflect.TypeOf(suite)
 	sv := reflect.ValueOf(suite)
 	count := tp.NumMethod()
+	typeName := sv.Elem().Type().String()
 
 	runner = &Runner{
-		results: make([]*result, 0, 10),
+		results:   make([]*result, 0, 10),
+		reporters: newReporters(),
+	}
+	for _, r := range runner.reporters {
+		r.StartSuite(typeName)
 	}
 
 	each, _ := tp.MethodByName("Each")
@@ -70,7 +93,6 @@ func Expectify(suite interface{}, t *testing.T) {
 		each = reflect.Method{}
 	}
 
-	announced := false
 	for i := 0; i < count; i++ {
 		method := tp.Method(i)
 		// this method is not exported
@@ -78,7 +100,6 @@ func Expectify(suite interface{}, t *testing.T) {
 			continue
 		}
 		name = method.Name
-		typeName := sv.Elem().Type().String()
 
 		if method.Type.NumIn() != 1 {
 			continue
@@ -90,14 +111,14 @@ func Expectify(suite interface{}, t *testing.T) {
 
 		os.Stdout = stdout
 		res = runner.Start(name, typeName)
+		for _, r := range runner.reporters {
+			r.StartTest(name, typeName)
+		}
 		var f = func() {
 			method.Func.Call([]reflect.Value{sv})
-			if runner.End() == false || testing.Verbose() {
-				if announced == false {
-					color.Printf("\n@!%s@|\n", typeName)
-					announced = true
-				}
-				res.Report()
+			runner.End()
+			for _, r := range runner.reporters {
+				r.TestResult(res)
 			}
 		}
 		for i := 0; i < len(beforeEach); i++ {
@@ -121,16 +142,14 @@ func finish(t *testing.T) {
 		}
 	}
 	failed := len(runner.results) - passed
+
+	os.Stdout = stdout
+	for _, r := range runner.reporters {
+		r.Finish(runner.results)
+	}
+	os.Stdout = silentOut
+
 	if failed != 0 {
-		os.Stdout = stdout
-		fmt.Println("\nFailure summary")
-		for _, r := range runner.results {
-			if r.Passed() == false {
-				r.Summary()
-			}
-		}
-		fmt.Println()
-		os.Stdout = silentOut
 		t.Fail()
 	}
 	if path := *summaryPath; len(path) != 0 {
@@ -138,6 +157,21 @@ func finish(t *testing.T) {
 	}
 }
 
+// newReporters builds the set of Reporters to notify as a suite runs: the
+// terminal reporter is always present, and a JUnit-XML and/or
+// newline-delimited JSON reporter are added when -junit/-json point at a
+// file.
+func newReporters() []Reporter {
+	reporters := []Reporter{NewTerminalReporter()}
+	if path := *junitPath; len(path) != 0 {
+		reporters = append(reporters, NewJUnitReporter(path))
+	}
+	if path := *jsonPath; len(path) != 0 {
+		reporters = append(reporters, NewJSONReporter(path))
+	}
+	return reporters
+}
+
 func updatePersistedSummary(path string, passed int, failed int) {
 	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0600)
 	if err != nil {
@@ -174,8 +208,9 @@ func BeforeEach(f func()) {
 }
 
 type Runner struct {
-	results []*result
-	current *result
+	results   []*result
+	current   *result
+	reporters []Reporter
 }
 
 func (r *Runner) Start(name string, typeName string) *result {
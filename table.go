@@ -0,0 +1,97 @@
+package expect
+
+import "fmt"
+
+// ValueAssertion matches a single-value assertion such as Nil or NotNil,
+// mirroring testify's ValueAssertionFunc so table-driven tests can store a
+// matcher function instead of spelling out the fluent chain.
+type ValueAssertion func(t TestingT, actual interface{}, msgAndArgs ...interface{}) PostHandler
+
+// ComparisonAssertion matches a two-value assertion such as Equal or
+// Contains, mirroring testify's ComparisonAssertionFunc.
+type ComparisonAssertion func(t TestingT, actual, expected interface{}, msgAndArgs ...interface{}) PostHandler
+
+// messageCapture is a TestingT that records an assertion's own failure
+// message instead of reporting it, so withMessage can report the failure
+// to the real t exactly once - substituting the caller's msgAndArgs for
+// the assertion's own message rather than reporting both.
+type messageCapture struct {
+	t       TestingT
+	message string
+	failed  bool
+}
+
+func (c *messageCapture) Errorf(format string, args ...interface{}) {
+	c.failed = true
+	c.message = fmt.Sprintf(format, args...)
+}
+
+func (c *messageCapture) Helper() {
+	if c.t != nil {
+		c.t.Helper()
+	}
+}
+
+// withMessage reports capture's failure through t, using msgAndArgs in
+// place of the assertion's own message when the caller supplied one.
+// Each assertion runs against capture rather than t directly so that its
+// own message can be held back and replaced; capture is never nil, so the
+// assertion itself never reports through the global runner, which is nil
+// outside Expectify.
+func withMessage(t TestingT, capture *messageCapture, handler PostHandler, msgAndArgs ...interface{}) PostHandler {
+	if !capture.failed {
+		return handler
+	}
+	if len(msgAndArgs) > 0 {
+		if format, ok := msgAndArgs[0].(string); ok {
+			dispatch(t, format, msgAndArgs[1:]...)
+			return handler
+		}
+	}
+	dispatch(t, "%s", capture.message)
+	return handler
+}
+
+// Equal asserts that actual equals expected. It builds an Expectation
+// internally so it can be used as a ComparisonAssertion in table-driven
+// tests, without requiring the fluent Expect(...).To.Equal(...) chain.
+func Equal(t TestingT, actual, expected interface{}, msgAndArgs ...interface{}) PostHandler {
+	capture := &messageCapture{t: t}
+	return withMessage(t, capture, ExpectT(capture, actual).To.Equal(expected), msgAndArgs...)
+}
+
+// NotEqual asserts that actual does not equal expected.
+func NotEqual(t TestingT, actual, expected interface{}, msgAndArgs ...interface{}) PostHandler {
+	capture := &messageCapture{t: t}
+	return withMessage(t, capture, notExpect(capture, actual).To.Equal(expected), msgAndArgs...)
+}
+
+// Nil asserts that actual is nil.
+func Nil(t TestingT, actual interface{}, msgAndArgs ...interface{}) PostHandler {
+	capture := &messageCapture{t: t}
+	return withMessage(t, capture, ExpectT(capture, actual).To.BeNil(), msgAndArgs...)
+}
+
+// NotNil asserts that actual is not nil.
+func NotNil(t TestingT, actual interface{}, msgAndArgs ...interface{}) PostHandler {
+	capture := &messageCapture{t: t}
+	return withMessage(t, capture, notExpect(capture, actual).To.BeNil(), msgAndArgs...)
+}
+
+// Contains asserts that actual contains expected.
+func Contains(t TestingT, actual, expected interface{}, msgAndArgs ...interface{}) PostHandler {
+	capture := &messageCapture{t: t}
+	return withMessage(t, capture, ExpectT(capture, actual).To.Contain(expected), msgAndArgs...)
+}
+
+// Greater asserts that actual is greater than expected.
+func Greater(t TestingT, actual, expected interface{}, msgAndArgs ...interface{}) PostHandler {
+	capture := &messageCapture{t: t}
+	return withMessage(t, capture, ExpectT(capture, actual).GreaterThan(expected), msgAndArgs...)
+}
+
+// Less asserts that actual is less than expected.
+func Less(t TestingT, actual, expected interface{}, msgAndArgs ...interface{}) PostHandler {
+	capture := &messageCapture{t: t}
+	return withMessage(t, capture, ExpectT(capture, actual).LessThan(expected), msgAndArgs...)
+}
@@ -0,0 +1,70 @@
+package expect
+
+import "testing"
+
+func TestTableDrivenAssertions(t *testing.T) {
+	tests := []struct {
+		name      string
+		actual    interface{}
+		expected  interface{}
+		assertion ComparisonAssertion
+	}{
+		{"Equal", 1, 1, Equal},
+		{"NotEqual", 1, 2, NotEqual},
+		{"Contains", []int{1, 2, 3}, 2, Contains},
+		{"Greater", 2, 1, Greater},
+		{"Less", 1, 2, Less},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.assertion(t, tt.actual, tt.expected) != SuccessHandler {
+				t.Errorf("%s(%v, %v) did not pass", tt.name, tt.actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTableDrivenValueAssertions(t *testing.T) {
+	tests := []struct {
+		name      string
+		actual    interface{}
+		assertion ValueAssertion
+	}{
+		{"Nil", nil, Nil},
+		{"NotNil", 1, NotNil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.assertion(t, tt.actual) != SuccessHandler {
+				t.Errorf("%s(%v) did not pass", tt.name, tt.actual)
+			}
+		})
+	}
+}
+
+// Equal et al. must work standalone, without Expectify ever having run,
+// since that's the entire point of exposing them as plain functions for
+// table-driven tests; before the TestingT plumbing this hit a nil global
+// runner.
+func TestTableDrivenAssertionsOutsideExpectify(t *testing.T) {
+	c := &captureT{T: t}
+	if Equal(c, 1, 2) != FailureHandler {
+		t.Error("expected Equal(1, 2) to fail")
+	}
+}
+
+// Supplying msgAndArgs must replace Equal's own failure message, not
+// report both; withMessage used to call through to the assertion's
+// default errorf in addition to the custom one.
+func TestTableDrivenAssertionsCustomMessage(t *testing.T) {
+	c := &captureT{T: t}
+	if Equal(c, 1, 2, "custom message") != FailureHandler {
+		t.Error("expected Equal(1, 2, ...) to fail")
+	}
+	if len(c.messages) != 1 {
+		t.Fatalf("expected exactly one reported failure, got %d: %v", len(c.messages), c.messages)
+	}
+	if c.messages[0] != "custom message" {
+		t.Errorf("expected the custom message to be reported, got %q", c.messages[0])
+	}
+}
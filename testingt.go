@@ -0,0 +1,33 @@
+package expect
+
+// TestingT is the subset of *testing.T (and *testing.B) that expect needs
+// to report failures. Implementing it lets Expect be used inside ordinary
+// go test suites and t.Run subtests, where the global runner-based
+// Expectify flow doesn't reach.
+//
+// This intentionally narrows testify's TestingT, which also has SkipNow
+// and Name: expect never skips or names a test on behalf of the caller,
+// so those methods would sit unused on every implementation.
+type TestingT interface {
+	Errorf(format string, args ...interface{})
+	Helper()
+}
+
+// dispatch reports a failure through t when one was supplied, or through
+// the global runner otherwise, so the same assertion code paths serve both
+// ExpectT and the classic Expect/Expectify flow.
+func dispatch(t TestingT, format string, args ...interface{}) {
+	if t != nil {
+		t.Helper()
+		t.Errorf(format, args...)
+		return
+	}
+	Errorf(format, args...)
+}
+
+// ExpectT builds an Expectation whose failures are reported to t instead of
+// the package-global runner, so it can be used inside a standard testing.T
+// test or a t.Run subtest rather than only from within Expectify.
+func ExpectT(t TestingT, actual interface{}, others ...interface{}) *Expectation {
+	return expect(t, actual, others, true)
+}
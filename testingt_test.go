@@ -0,0 +1,50 @@
+package expect
+
+import (
+	"fmt"
+	"testing"
+)
+
+// stubT is a minimal TestingT that never touches *testing.T, used to prove
+// ExpectT can report failures without a real test, and in particular
+// without ever falling through to the nilable global runner.
+type stubT struct {
+	failed  bool
+	message string
+}
+
+func (s *stubT) Errorf(format string, args ...interface{}) {
+	s.failed = true
+	s.message = fmt.Sprintf(format, args...)
+}
+
+func (s *stubT) Helper() {}
+
+func TestExpectTReportsThroughTestingT(t *testing.T) {
+	s := &stubT{}
+	if ExpectT(s, 1).To.Equal(1) != SuccessHandler {
+		t.Error("expected 1 to equal 1")
+	}
+	if s.failed {
+		t.Errorf("did not expect a failure, got %q", s.message)
+	}
+
+	s = &stubT{}
+	if ExpectT(s, 1).To.Equal(2) != FailureHandler {
+		t.Error("expected 1 to not equal 2")
+	}
+	if !s.failed {
+		t.Error("expected ExpectT to report the failure through TestingT")
+	}
+}
+
+// Before ExpectT existed, any failure path reached for the package-global
+// runner, which panics on a nil runner outside Expectify; ExpectT must
+// route through t instead and never touch that global.
+func TestExpectTNeverTouchesGlobalRunner(t *testing.T) {
+	s := &stubT{}
+	ExpectT(s, 1).Not.ToEqual(1)
+	if !s.failed {
+		t.Error("expected Not.ToEqual(1) on 1 to fail")
+	}
+}